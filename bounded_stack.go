@@ -0,0 +1,313 @@
+package stack
+
+import (
+	"errors"
+	"fmt"
+)
+
+// OverflowPolicy controls what a BoundedStack does when Push is called
+// while the stack is already at capacity.
+type OverflowPolicy int
+
+const (
+	// ErrorOnFull makes Push return an error instead of adding the value.
+	ErrorOnFull OverflowPolicy = iota
+
+	// DropOldest discards the bottom element before pushing the new value,
+	// keeping the stack at capacity (plate-stack / ring-buffer semantics).
+	DropOldest
+
+	// Overwrite discards the top element before pushing the new value, so
+	// the new value replaces whatever was most recently on top.
+	Overwrite
+)
+
+// ErrStackFull is returned by BoundedStack.Push when the stack is at
+// capacity and its OverflowPolicy is ErrorOnFull.
+var ErrStackFull = errors.New("stack full")
+
+// BoundedStack is a fixed-capacity implementation of Stack[T].
+//
+// BoundedStack[T] holds at most Capacity elements. Once full, Push behaves
+// according to the stack's OverflowPolicy: returning an error, dropping the
+// oldest (bottom) element, or overwriting the newest (top) element.
+type BoundedStack[T comparable] struct {
+	data     []T
+	capacity int
+	policy   OverflowPolicy
+}
+
+// NewBoundedStack creates and returns a new empty BoundedStack for type T
+// with the given capacity and overflow policy.
+//
+// Parameters:
+//   - capacity: The maximum number of elements the stack may hold.
+//   - policy: The OverflowPolicy applied when Push is called while full.
+//
+// Returns:
+//   - *BoundedStack[T]: A new empty bounded stack for type T.
+//
+// Example:
+//
+//	s := stack.NewBoundedStack[int](2, stack.DropOldest)
+//	s.Push(1)
+//	s.Push(2)
+//	s.Push(3)
+//	fmt.Println(s) // Stack: [2 3]
+func NewBoundedStack[T comparable](capacity int, policy OverflowPolicy) *BoundedStack[T] {
+	if capacity < 0 {
+		capacity = 0
+	}
+	return &BoundedStack[T]{
+		data:     make([]T, 0, capacity),
+		capacity: capacity,
+		policy:   policy,
+	}
+}
+
+// Push adds a new element to the top of the stack.
+//
+// If the stack is already at capacity, Push applies the stack's
+// OverflowPolicy: ErrorOnFull discards the value and returns an error,
+// DropOldest removes the bottom element first, and Overwrite removes the
+// top element first. A stack with capacity 0 can hold nothing, so Push
+// always returns ErrStackFull for it, whatever the policy.
+//
+// Parameters:
+//   - data: The element to be added to the stack.
+//
+// Returns:
+//   - error: ErrStackFull if the stack is at capacity and its policy is
+//     ErrorOnFull, or if its capacity is 0; nil otherwise.
+//
+// Example:
+//
+//	s := stack.NewBoundedStack[int](1, stack.ErrorOnFull)
+//	s.Push(1)
+//	err := s.Push(2)
+//	fmt.Println(err) // stack full
+func (s *BoundedStack[T]) Push(data T) error {
+	if s.capacity == 0 {
+		return ErrStackFull
+	}
+	if len(s.data) >= s.capacity {
+		switch s.policy {
+		case DropOldest:
+			s.data = s.data[1:]
+		case Overwrite:
+			s.data = s.data[:len(s.data)-1]
+		default:
+			return ErrStackFull
+		}
+	}
+	s.data = append(s.data, data)
+	return nil
+}
+
+// Pop removes and returns the top element of the stack.
+//
+// Returns:
+//   - value: The top element of the stack.
+//   - error: An error if the stack is empty.
+//
+// If the stack is empty, Pop returns the zero value of T and an error.
+//
+// Example:
+//
+//	s := stack.NewBoundedStack[int](2, stack.ErrorOnFull)
+//	s.Push(1)
+//	value, err := s.Pop()
+//	if err == nil {
+//	    fmt.Println(value) // 1
+//	}
+func (s *BoundedStack[T]) Pop() (T, error) {
+	if s.IsEmpty() {
+		var zero T
+		return zero, errors.New("stack empty")
+	}
+	index := len(s.data) - 1
+	value := s.data[index]
+	s.data = s.data[:index]
+	return value, nil
+}
+
+// Peek returns the top element of the stack without removing it.
+//
+// Returns:
+//   - value: The top element of the stack.
+//   - error: An error if the stack is empty.
+//
+// Example:
+//
+//	s := stack.NewBoundedStack[int](2, stack.ErrorOnFull)
+//	s.Push(5)
+//	top, err := s.Peek()
+//	if err == nil {
+//	    fmt.Println(top) // 5
+//	}
+func (s *BoundedStack[T]) Peek() (T, error) {
+	if s.IsEmpty() {
+		var zero T
+		return zero, errors.New("stack empty")
+	}
+	return s.data[len(s.data)-1], nil
+}
+
+// IsEmpty reports whether the stack contains no elements.
+//
+// Returns:
+//   - bool: true if the stack is empty; false otherwise.
+//
+// Example:
+//
+//	s := stack.NewBoundedStack[int](2, stack.ErrorOnFull)
+//	fmt.Println(s.IsEmpty()) // true
+func (s *BoundedStack[T]) IsEmpty() bool {
+	return len(s.data) == 0
+}
+
+// Size returns the number of elements currently in the stack.
+//
+// Returns:
+//   - int: The count of elements in the stack.
+//
+// Example:
+//
+//	s := stack.NewBoundedStack[int](2, stack.ErrorOnFull)
+//	s.Push(1)
+//	fmt.Println(s.Size()) // 1
+func (s *BoundedStack[T]) Size() int {
+	return len(s.data)
+}
+
+// Capacity returns the maximum number of elements the stack may hold.
+//
+// Returns:
+//   - int: The stack's configured capacity.
+//
+// Example:
+//
+//	s := stack.NewBoundedStack[int](2, stack.ErrorOnFull)
+//	fmt.Println(s.Capacity()) // 2
+func (s *BoundedStack[T]) Capacity() int {
+	return s.capacity
+}
+
+// IsFull reports whether the stack has reached its configured capacity.
+//
+// Returns:
+//   - bool: true if the stack is at capacity; false otherwise.
+//
+// Example:
+//
+//	s := stack.NewBoundedStack[int](1, stack.ErrorOnFull)
+//	s.Push(1)
+//	fmt.Println(s.IsFull()) // true
+func (s *BoundedStack[T]) IsFull() bool {
+	return len(s.data) >= s.capacity
+}
+
+// Clear removes all elements from the stack, resetting it to empty.
+//
+// Example:
+//
+//	s := stack.NewBoundedStack[int](2, stack.ErrorOnFull)
+//	s.Push(1)
+//	s.Clear()
+//	fmt.Println(s.IsEmpty()) // true
+func (s *BoundedStack[T]) Clear() {
+	s.data = s.data[:0]
+}
+
+// String returns a string representation of the stack.
+//
+// Returns:
+//   - string: A string representation of the stack.
+//
+// Example:
+//
+//	s := stack.NewBoundedStack[int](2, stack.ErrorOnFull)
+//	s.Push(1)
+//	s.Push(2)
+//	fmt.Println(s.String()) // Stack: [1 2]
+func (s *BoundedStack[T]) String() string {
+	return fmt.Sprintf("Stack: %v", s.data)
+}
+
+// Clone creates and returns a deep copy of the stack.
+//
+// Returns:
+//   - Stack[T]: A new stack with the same elements, capacity, and policy.
+//
+// Example:
+//
+//	s := stack.NewBoundedStack[int](2, stack.ErrorOnFull)
+//	s.Push(1)
+//	clone := s.Clone()
+//	fmt.Println(clone) // Stack: [1]
+func (s *BoundedStack[T]) Clone() Stack[T] {
+	newData := make([]T, len(s.data), s.capacity)
+	copy(newData, s.data)
+	return &BoundedStack[T]{data: newData, capacity: s.capacity, policy: s.policy}
+}
+
+// Reverse reverses the order of elements in the stack.
+//
+// Example:
+//
+//	s := stack.NewBoundedStack[int](3, stack.ErrorOnFull)
+//	s.Push(1)
+//	s.Push(2)
+//	s.Push(3)
+//	s.Reverse()
+//	fmt.Println(s) // Stack: [3 2 1]
+func (s *BoundedStack[T]) Reverse() {
+	n := len(s.data) - 1
+	for i, j := 0, n; i < j; i, j = i+1, j-1 {
+		s.data[i], s.data[j] = s.data[j], s.data[i]
+	}
+}
+
+// ToSlice returns a copy of the stack's elements as a slice.
+//
+// Returns:
+//   - []T: A copy of the stack's internal slice.
+//
+// Example:
+//
+//	s := stack.NewBoundedStack[int](2, stack.ErrorOnFull)
+//	s.Push(1)
+//	s.Push(2)
+//	slice := s.ToSlice()
+//	fmt.Println(slice) // [1 2]
+func (s *BoundedStack[T]) ToSlice() []T {
+	result := make([]T, len(s.data))
+	copy(result, s.data)
+	return result
+}
+
+// Contains reports whether the stack contains the given value.
+//
+// Parameters:
+//   - value: The value to search for.
+//
+// Returns:
+//   - bool: true if the value exists in the stack; false otherwise.
+//
+// Example:
+//
+//	s := stack.NewBoundedStack[int](2, stack.ErrorOnFull)
+//	s.Push(10)
+//	fmt.Println(s.Contains(10)) // true
+//	fmt.Println(s.Contains(5))  // false
+func (s *BoundedStack[T]) Contains(value T) bool {
+	for _, v := range s.data {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// compile-time check that BoundedStack implements Stack.
+var _ Stack[int] = (*BoundedStack[int])(nil)
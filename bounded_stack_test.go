@@ -0,0 +1,84 @@
+package stack
+
+import "testing"
+
+func TestBoundedStackErrorOnFull(t *testing.T) {
+	s := NewBoundedStack[int](2, ErrorOnFull)
+	if err := s.Push(1); err != nil {
+		t.Error("unexpected error on Push:", err)
+	}
+	if err := s.Push(2); err != nil {
+		t.Error("unexpected error on Push:", err)
+	}
+	if err := s.Push(3); err != ErrStackFull {
+		t.Errorf("expected ErrStackFull, got %v", err)
+	}
+	if s.Size() != 2 {
+		t.Errorf("expected size 2, got %d", s.Size())
+	}
+}
+
+func TestBoundedStackDropOldest(t *testing.T) {
+	s := NewBoundedStack[int](2, DropOldest)
+	s.Push(1)
+	s.Push(2)
+	if err := s.Push(3); err != nil {
+		t.Error("unexpected error on Push:", err)
+	}
+	slice := s.ToSlice()
+	if len(slice) != 2 || slice[0] != 2 || slice[1] != 3 {
+		t.Errorf("expected [2 3], got %v", slice)
+	}
+}
+
+func TestBoundedStackOverwrite(t *testing.T) {
+	s := NewBoundedStack[int](2, Overwrite)
+	s.Push(1)
+	s.Push(2)
+	if err := s.Push(3); err != nil {
+		t.Error("unexpected error on Push:", err)
+	}
+	slice := s.ToSlice()
+	if len(slice) != 2 || slice[0] != 1 || slice[1] != 3 {
+		t.Errorf("expected [1 3], got %v", slice)
+	}
+}
+
+func TestBoundedStackIsFull(t *testing.T) {
+	s := NewBoundedStack[int](1, ErrorOnFull)
+	if s.IsFull() {
+		t.Error("expected new stack to not be full")
+	}
+	s.Push(1)
+	if !s.IsFull() {
+		t.Error("expected stack to be full at capacity")
+	}
+}
+
+func TestBoundedStackPopAndPeek(t *testing.T) {
+	s := NewBoundedStack[int](2, ErrorOnFull)
+	_, err := s.Pop()
+	if err == nil {
+		t.Error("expected error when popping from empty stack")
+	}
+	s.Push(1)
+	s.Push(2)
+	top, err := s.Peek()
+	if err != nil || top != 2 {
+		t.Errorf("expected Peek value 2, got %d (err: %v)", top, err)
+	}
+	value, err := s.Pop()
+	if err != nil || value != 2 {
+		t.Errorf("expected popped value 2, got %d (err: %v)", value, err)
+	}
+}
+
+func TestBoundedStackClone(t *testing.T) {
+	s := NewBoundedStack[int](2, ErrorOnFull)
+	s.Push(1)
+	clone := s.Clone()
+	clone.Push(2)
+	if s.Size() == clone.Size() {
+		t.Error("expected original and clone to diverge after modifying clone")
+	}
+}
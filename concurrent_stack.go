@@ -0,0 +1,231 @@
+package stack
+
+import (
+	"context"
+	"sync"
+)
+
+// ConcurrentStack wraps a SliceStack with a mutex and condition variable,
+// making it safe to share between goroutines and usable as a coordination
+// primitive (producer/consumer-style DFS workers, parser worklists) without
+// callers having to bolt on their own locking.
+type ConcurrentStack[T comparable] struct {
+	mu    sync.Mutex
+	cond  *sync.Cond
+	stack *SliceStack[T]
+}
+
+// NewConcurrentStack creates and returns a new empty ConcurrentStack for
+// type T.
+//
+// Returns:
+//   - *ConcurrentStack[T]: A new empty concurrent stack for type T.
+//
+// Example:
+//
+//	s := stack.NewConcurrentStack[int]()
+//	s.Push(42)
+func NewConcurrentStack[T comparable]() *ConcurrentStack[T] {
+	s := &ConcurrentStack[T]{stack: NewStack[T]()}
+	s.cond = sync.NewCond(&s.mu)
+	return s
+}
+
+// Push adds a new element to the top of the stack and wakes any goroutine
+// blocked in PopBlocking.
+//
+// Parameters:
+//   - value: The element to be added to the stack.
+//
+// Example:
+//
+//	s := stack.NewConcurrentStack[int]()
+//	s.Push(1)
+func (s *ConcurrentStack[T]) Push(value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stack.Push(value)
+	s.cond.Signal()
+}
+
+// PushAll adds multiple elements to the top of the stack as a single
+// atomic operation, then wakes any goroutines blocked in PopBlocking.
+//
+// Parameters:
+//   - vals: The elements to be added, pushed in order.
+//
+// Example:
+//
+//	s := stack.NewConcurrentStack[int]()
+//	s.PushAll(1, 2, 3)
+func (s *ConcurrentStack[T]) PushAll(vals ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, v := range vals {
+		s.stack.Push(v)
+	}
+	s.cond.Broadcast()
+}
+
+// Pop removes and returns the top element of the stack.
+//
+// Returns:
+//   - value: The top element of the stack.
+//   - error: An error if the stack is empty.
+//
+// Example:
+//
+//	s := stack.NewConcurrentStack[int]()
+//	s.Push(1)
+//	value, err := s.Pop()
+//	if err == nil {
+//	    fmt.Println(value) // 1
+//	}
+func (s *ConcurrentStack[T]) Pop() (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Pop()
+}
+
+// TryPop removes and returns the top element of the stack without
+// blocking.
+//
+// Returns:
+//   - value: The top element of the stack.
+//   - ok: true if an element was popped; false if the stack was empty.
+//
+// Example:
+//
+//	s := stack.NewConcurrentStack[int]()
+//	value, ok := s.TryPop()
+//	fmt.Println(ok) // false
+func (s *ConcurrentStack[T]) TryPop() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	value, err := s.stack.Pop()
+	return value, err == nil
+}
+
+// PopBlocking removes and returns the top element of the stack, blocking
+// until an element is available or ctx is cancelled.
+//
+// Parameters:
+//   - ctx: The context governing cancellation of the wait.
+//
+// Returns:
+//   - value: The top element of the stack.
+//   - error: ctx.Err() if ctx is cancelled before an element is available.
+//
+// Example:
+//
+//	s := stack.NewConcurrentStack[int]()
+//	go func() { s.Push(1) }()
+//	value, err := s.PopBlocking(context.Background())
+func (s *ConcurrentStack[T]) PopBlocking(ctx context.Context) (T, error) {
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.mu.Lock()
+			s.cond.Broadcast()
+			s.mu.Unlock()
+		case <-done:
+		}
+	}()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for s.stack.IsEmpty() {
+		if err := ctx.Err(); err != nil {
+			var zero T
+			return zero, err
+		}
+		s.cond.Wait()
+	}
+	return s.stack.Pop()
+}
+
+// Peek returns the top element of the stack without removing it.
+//
+// Returns:
+//   - value: The top element of the stack.
+//   - error: An error if the stack is empty.
+//
+// Example:
+//
+//	s := stack.NewConcurrentStack[int]()
+//	s.Push(5)
+//	top, err := s.Peek()
+//	if err == nil {
+//	    fmt.Println(top) // 5
+//	}
+func (s *ConcurrentStack[T]) Peek() (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Peek()
+}
+
+// Size returns the number of elements currently in the stack.
+//
+// Returns:
+//   - int: The count of elements in the stack.
+//
+// Example:
+//
+//	s := stack.NewConcurrentStack[int]()
+//	s.Push(1)
+//	fmt.Println(s.Size()) // 1
+func (s *ConcurrentStack[T]) Size() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Size()
+}
+
+// IsEmpty reports whether the stack contains no elements.
+//
+// Returns:
+//   - bool: true if the stack is empty; false otherwise.
+//
+// Example:
+//
+//	s := stack.NewConcurrentStack[int]()
+//	fmt.Println(s.IsEmpty()) // true
+func (s *ConcurrentStack[T]) IsEmpty() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.IsEmpty()
+}
+
+// DrainTo atomically pops every element from the stack and pushes it onto
+// dst, returning the number of elements moved. Since each element is
+// popped from the top and pushed onto dst in turn, the transfer reverses
+// the elements' order.
+//
+// Parameters:
+//   - dst: The stack to receive the drained elements.
+//
+// Returns:
+//   - int: The number of elements moved.
+//
+// Example:
+//
+//	s := stack.NewConcurrentStack[int]()
+//	s.PushAll(1, 2, 3)
+//	dst := stack.NewStack[int]()
+//	n := s.DrainTo(dst)
+//	fmt.Println(n) // 3
+func (s *ConcurrentStack[T]) DrainTo(dst *SliceStack[T]) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for {
+		value, err := s.stack.Pop()
+		if err != nil {
+			break
+		}
+		dst.Push(value)
+		n++
+	}
+	return n
+}
@@ -0,0 +1,88 @@
+package stack
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestConcurrentStackPushAndPop(t *testing.T) {
+	s := NewConcurrentStack[int]()
+	s.Push(1)
+	s.Push(2)
+	if s.Size() != 2 {
+		t.Errorf("expected size 2, got %d", s.Size())
+	}
+	value, err := s.Pop()
+	if err != nil || value != 2 {
+		t.Errorf("expected popped value 2, got %d (err: %v)", value, err)
+	}
+}
+
+func TestConcurrentStackTryPop(t *testing.T) {
+	s := NewConcurrentStack[int]()
+	if _, ok := s.TryPop(); ok {
+		t.Error("expected TryPop to report false on empty stack")
+	}
+	s.Push(1)
+	value, ok := s.TryPop()
+	if !ok || value != 1 {
+		t.Errorf("expected TryPop to return 1, got %d (ok: %v)", value, ok)
+	}
+}
+
+func TestConcurrentStackPushAll(t *testing.T) {
+	s := NewConcurrentStack[int]()
+	s.PushAll(1, 2, 3)
+	if s.Size() != 3 {
+		t.Errorf("expected size 3, got %d", s.Size())
+	}
+}
+
+func TestConcurrentStackDrainTo(t *testing.T) {
+	s := NewConcurrentStack[int]()
+	s.PushAll(1, 2, 3)
+	dst := NewStack[int]()
+	n := s.DrainTo(dst)
+	if n != 3 {
+		t.Errorf("expected 3 elements drained, got %d", n)
+	}
+	if !s.IsEmpty() {
+		t.Error("expected source stack to be empty after DrainTo")
+	}
+	if dst.Size() != 3 {
+		t.Errorf("expected destination size 3, got %d", dst.Size())
+	}
+}
+
+func TestConcurrentStackPopBlockingWakesOnPush(t *testing.T) {
+	s := NewConcurrentStack[int]()
+	result := make(chan int, 1)
+	go func() {
+		value, err := s.PopBlocking(context.Background())
+		if err != nil {
+			return
+		}
+		result <- value
+	}()
+	time.Sleep(10 * time.Millisecond)
+	s.Push(42)
+	select {
+	case value := <-result:
+		if value != 42 {
+			t.Errorf("expected 42, got %d", value)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("PopBlocking did not return after Push")
+	}
+}
+
+func TestConcurrentStackPopBlockingCancel(t *testing.T) {
+	s := NewConcurrentStack[int]()
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := s.PopBlocking(ctx)
+	if err == nil {
+		t.Error("expected error when context is cancelled before an element is available")
+	}
+}
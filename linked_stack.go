@@ -0,0 +1,258 @@
+package stack
+
+import (
+	"errors"
+	"fmt"
+)
+
+// linkedNode is a single element in a LinkedStack's backing chain.
+type linkedNode[T comparable] struct {
+	value T
+	next  *linkedNode[T]
+}
+
+// LinkedStack is a singly-linked-node implementation of Stack[T].
+//
+// LinkedStack[T] holds elements of any comparable type T. Each element is
+// stored in its own node, so Push and Pop are O(1) with no reallocation
+// spikes, at the cost of one allocation per element and no contiguous
+// memory layout.
+type LinkedStack[T comparable] struct {
+	top  *linkedNode[T]
+	size int
+}
+
+// NewLinkedStack creates and returns a new empty LinkedStack for type T.
+//
+// Returns:
+//   - *LinkedStack[T]: A new empty stack for type T.
+//
+// Example:
+//
+//	s := stack.NewLinkedStack[int]()
+//	s.Push(42)
+//	fmt.Println(s) // Stack: [42]
+func NewLinkedStack[T comparable]() *LinkedStack[T] {
+	return &LinkedStack[T]{}
+}
+
+// Push adds a new element to the top of the stack. It always succeeds.
+//
+// Parameters:
+//   - data: The element to be added to the stack.
+//
+// Returns:
+//   - error: Always nil; present to satisfy the Stack[T] interface.
+//
+// Example:
+//
+//	s := stack.NewLinkedStack[string]()
+//	s.Push("hello")
+//	s.Push("world")
+func (s *LinkedStack[T]) Push(data T) error {
+	s.top = &linkedNode[T]{value: data, next: s.top}
+	s.size++
+	return nil
+}
+
+// Pop removes and returns the top element of the stack.
+//
+// Returns:
+//   - value: The top element of the stack.
+//   - error: An error if the stack is empty.
+//
+// If the stack is empty, Pop returns the zero value of T and an error.
+//
+// Example:
+//
+//	s := stack.NewLinkedStack[int]()
+//	s.Push(1)
+//	value, err := s.Pop()
+//	if err == nil {
+//	    fmt.Println(value) // 1
+//	}
+func (s *LinkedStack[T]) Pop() (T, error) {
+	if s.IsEmpty() {
+		var zero T
+		return zero, errors.New("stack empty")
+	}
+	value := s.top.value
+	s.top = s.top.next
+	s.size--
+	return value, nil
+}
+
+// Peek returns the top element of the stack without removing it.
+//
+// Returns:
+//   - value: The top element of the stack.
+//   - error: An error if the stack is empty.
+//
+// Example:
+//
+//	s := stack.NewLinkedStack[int]()
+//	s.Push(5)
+//	top, err := s.Peek()
+//	if err == nil {
+//	    fmt.Println(top) // 5
+//	}
+func (s *LinkedStack[T]) Peek() (T, error) {
+	if s.IsEmpty() {
+		var zero T
+		return zero, errors.New("stack empty")
+	}
+	return s.top.value, nil
+}
+
+// IsEmpty reports whether the stack contains no elements.
+//
+// Returns:
+//   - bool: true if the stack is empty; false otherwise.
+//
+// Example:
+//
+//	s := stack.NewLinkedStack[int]()
+//	fmt.Println(s.IsEmpty()) // true
+func (s *LinkedStack[T]) IsEmpty() bool {
+	return s.size == 0
+}
+
+// Size returns the number of elements currently in the stack.
+//
+// Returns:
+//   - int: The count of elements in the stack.
+//
+// Example:
+//
+//	s := stack.NewLinkedStack[int]()
+//	s.Push(1)
+//	s.Push(2)
+//	fmt.Println(s.Size()) // 2
+func (s *LinkedStack[T]) Size() int {
+	return s.size
+}
+
+// Clear removes all elements from the stack, resetting it to empty.
+//
+// Example:
+//
+//	s := stack.NewLinkedStack[int]()
+//	s.Push(1)
+//	s.Clear()
+//	fmt.Println(s.IsEmpty()) // true
+func (s *LinkedStack[T]) Clear() {
+	s.top = nil
+	s.size = 0
+}
+
+// String returns a string representation of the stack.
+//
+// Returns:
+//   - string: A string representation of the stack.
+//
+// Example:
+//
+//	s := stack.NewLinkedStack[int]()
+//	s.Push(1)
+//	s.Push(2)
+//	fmt.Println(s.String()) // Stack: [1 2]
+func (s *LinkedStack[T]) String() string {
+	return fmt.Sprintf("Stack: %v", s.ToSlice())
+}
+
+// Clone creates and returns a deep copy of the stack.
+//
+// Returns:
+//   - Stack[T]: A new stack with the same elements.
+//
+// Example:
+//
+//	s := stack.NewLinkedStack[int]()
+//	s.Push(1)
+//	clone := s.Clone()
+//	fmt.Println(clone) // Stack: [1]
+func (s *LinkedStack[T]) Clone() Stack[T] {
+	clone := &LinkedStack[T]{size: s.size}
+	if s.top == nil {
+		return clone
+	}
+	nodes := make([]T, 0, s.size)
+	for n := s.top; n != nil; n = n.next {
+		nodes = append(nodes, n.value)
+	}
+	for i := len(nodes) - 1; i >= 0; i-- {
+		clone.top = &linkedNode[T]{value: nodes[i], next: clone.top}
+	}
+	return clone
+}
+
+// Reverse reverses the order of elements in the stack.
+//
+// Example:
+//
+//	s := stack.NewLinkedStack[int]()
+//	s.Push(1)
+//	s.Push(2)
+//	s.Push(3)
+//	s.Reverse()
+//	fmt.Println(s) // Stack: [3 2 1]
+func (s *LinkedStack[T]) Reverse() {
+	var prev *linkedNode[T]
+	current := s.top
+	for current != nil {
+		next := current.next
+		current.next = prev
+		prev = current
+		current = next
+	}
+	s.top = prev
+}
+
+// ToSlice returns a copy of the stack's elements as a slice, ordered from
+// bottom to top.
+//
+// Returns:
+//   - []T: A copy of the stack's elements.
+//
+// Example:
+//
+//	s := stack.NewLinkedStack[int]()
+//	s.Push(1)
+//	s.Push(2)
+//	slice := s.ToSlice()
+//	fmt.Println(slice) // [1 2]
+func (s *LinkedStack[T]) ToSlice() []T {
+	result := make([]T, s.size)
+	i := s.size - 1
+	for n := s.top; n != nil; n = n.next {
+		result[i] = n.value
+		i--
+	}
+	return result
+}
+
+// Contains reports whether the stack contains the given value.
+//
+// Parameters:
+//   - value: The value to search for.
+//
+// Returns:
+//   - bool: true if the value exists in the stack; false otherwise.
+//
+// Example:
+//
+//	s := stack.NewLinkedStack[int]()
+//	s.Push(10)
+//	fmt.Println(s.Contains(10)) // true
+//	fmt.Println(s.Contains(5))  // false
+func (s *LinkedStack[T]) Contains(value T) bool {
+	for n := s.top; n != nil; n = n.next {
+		if n.value == value {
+			return true
+		}
+	}
+	return false
+}
+
+// compile-time check that LinkedStack implements Stack.
+var _ Stack[int] = (*LinkedStack[int])(nil)
@@ -0,0 +1,118 @@
+package stack
+
+import "testing"
+
+func TestLinkedStackPushAndPop(t *testing.T) {
+	s := NewLinkedStack[int]()
+	if !s.IsEmpty() {
+		t.Error("expected stack to be empty initially")
+	}
+	s.Push(10)
+	s.Push(20)
+	s.Push(30)
+	if s.Size() != 3 {
+		t.Errorf("expected size 3, got %d", s.Size())
+	}
+	value, err := s.Pop()
+	if err != nil {
+		t.Error("unexpected error on Pop:", err)
+	}
+	if value != 30 {
+		t.Errorf("expected popped value 30, got %d", value)
+	}
+	_, _ = s.Pop()
+	_, _ = s.Pop()
+	_, err = s.Pop()
+	if err == nil {
+		t.Error("expected error when popping from empty stack")
+	}
+}
+
+func TestLinkedStackPeek(t *testing.T) {
+	s := NewLinkedStack[string]()
+	_, err := s.Peek()
+	if err == nil {
+		t.Error("expected error on Peek from empty stack")
+	}
+	s.Push("foo")
+	s.Push("bar")
+	top, err := s.Peek()
+	if err != nil {
+		t.Error("unexpected error on Peek:", err)
+	}
+	if top != "bar" {
+		t.Errorf("expected Peek value 'bar', got '%s'", top)
+	}
+}
+
+func TestLinkedStackClear(t *testing.T) {
+	s := NewLinkedStack[int]()
+	s.Push(1)
+	s.Push(2)
+	s.Clear()
+	if !s.IsEmpty() || s.Size() != 0 {
+		t.Error("expected stack to be empty after Clear")
+	}
+}
+
+func TestLinkedStackClone(t *testing.T) {
+	s := NewLinkedStack[int]()
+	s.Push(1)
+	s.Push(2)
+	clone := s.Clone()
+	if clone.Size() != s.Size() {
+		t.Errorf("expected clone size %d, got %d", s.Size(), clone.Size())
+	}
+	clone.Push(3)
+	if s.Size() == clone.Size() {
+		t.Error("expected original and clone to diverge after modifying clone")
+	}
+}
+
+func TestLinkedStackReverse(t *testing.T) {
+	s := NewLinkedStack[int]()
+	for i := 1; i <= 3; i++ {
+		s.Push(i)
+	}
+	s.Reverse()
+	expected := []int{3, 2, 1}
+	actual := s.ToSlice()
+	for i, v := range expected {
+		if actual[i] != v {
+			t.Errorf("expected %v at position %d, got %v", v, i, actual[i])
+		}
+	}
+}
+
+func TestLinkedStackToSlice(t *testing.T) {
+	s := NewLinkedStack[int]()
+	s.Push(42)
+	s.Push(7)
+	slice := s.ToSlice()
+	if len(slice) != 2 || slice[0] != 42 || slice[1] != 7 {
+		t.Errorf("unexpected slice content: %v", slice)
+	}
+}
+
+func TestLinkedStackContains(t *testing.T) {
+	s := NewLinkedStack[string]()
+	s.Push("apple")
+	s.Push("banana")
+	if !s.Contains("apple") {
+		t.Error("expected stack to contain 'apple'")
+	}
+	if s.Contains("orange") {
+		t.Error("did not expect stack to contain 'orange'")
+	}
+}
+
+func TestLinkedStackString(t *testing.T) {
+	s := NewLinkedStack[int]()
+	s.Push(1)
+	s.Push(2)
+	got := s.String()
+	want := "Stack: [1 2]"
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,177 @@
+package stack
+
+import (
+	"cmp"
+	"errors"
+)
+
+// MinStack is a slice-backed stack that tracks the running minimum in O(1).
+//
+// MinStack[T] maintains an auxiliary stack of current minima alongside the
+// main data: on Push(x), x is also pushed onto the min stack if it is less
+// than or equal to the current minimum (or the stack is empty); on Pop, if
+// the popped value equals the min stack's top, the min stack is popped too.
+type MinStack[T any] struct {
+	data []T
+	min  []T
+	less func(a, b T) bool
+}
+
+// NewMinStack creates and returns a new empty MinStack for an ordered type
+// T, comparing elements with the standard < operator.
+//
+// Returns:
+//   - *MinStack[T]: A new empty min-stack for type T.
+//
+// Example:
+//
+//	s := stack.NewMinStack[int]()
+//	s.Push(3)
+//	s.Push(1)
+//	s.Push(2)
+//	min, _ := s.Min()
+//	fmt.Println(min) // 1
+func NewMinStack[T cmp.Ordered]() *MinStack[T] {
+	return NewMinStackFunc[T](func(a, b T) bool { return a < b })
+}
+
+// NewMinStackFunc creates and returns a new empty MinStack for any type T,
+// using the given less function to compare elements.
+//
+// Parameters:
+//   - less: A function reporting whether a should be ordered before b.
+//
+// Returns:
+//   - *MinStack[T]: A new empty min-stack for type T.
+//
+// Example:
+//
+//	type Point struct{ X int }
+//	s := stack.NewMinStackFunc[Point](func(a, b Point) bool { return a.X < b.X })
+//	s.Push(Point{X: 3})
+//	s.Push(Point{X: 1})
+//	min, _ := s.Min()
+//	fmt.Println(min.X) // 1
+func NewMinStackFunc[T any](less func(a, b T) bool) *MinStack[T] {
+	return &MinStack[T]{less: less}
+}
+
+// Push adds a new element to the top of the stack, updating the running
+// minimum if necessary.
+//
+// Parameters:
+//   - value: The element to be added to the stack.
+//
+// Example:
+//
+//	s := stack.NewMinStack[int]()
+//	s.Push(5)
+//	s.Push(2)
+func (s *MinStack[T]) Push(value T) {
+	s.data = append(s.data, value)
+	if len(s.min) == 0 || !s.less(s.min[len(s.min)-1], value) {
+		s.min = append(s.min, value)
+	}
+}
+
+// Pop removes and returns the top element of the stack, updating the
+// running minimum if necessary.
+//
+// Returns:
+//   - value: The top element of the stack.
+//   - error: An error if the stack is empty.
+//
+// Example:
+//
+//	s := stack.NewMinStack[int]()
+//	s.Push(1)
+//	value, err := s.Pop()
+//	if err == nil {
+//	    fmt.Println(value) // 1
+//	}
+func (s *MinStack[T]) Pop() (T, error) {
+	if s.IsEmpty() {
+		var zero T
+		return zero, errors.New("stack empty")
+	}
+	index := len(s.data) - 1
+	value := s.data[index]
+	s.data = s.data[:index]
+	top := s.min[len(s.min)-1]
+	if !s.less(value, top) && !s.less(top, value) {
+		s.min = s.min[:len(s.min)-1]
+	}
+	return value, nil
+}
+
+// Peek returns the top element of the stack without removing it.
+//
+// Returns:
+//   - value: The top element of the stack.
+//   - error: An error if the stack is empty.
+//
+// Example:
+//
+//	s := stack.NewMinStack[int]()
+//	s.Push(5)
+//	top, err := s.Peek()
+//	if err == nil {
+//	    fmt.Println(top) // 5
+//	}
+func (s *MinStack[T]) Peek() (T, error) {
+	if s.IsEmpty() {
+		var zero T
+		return zero, errors.New("stack empty")
+	}
+	return s.data[len(s.data)-1], nil
+}
+
+// Min returns the current minimum element of the stack in O(1).
+//
+// Returns:
+//   - value: The minimum element currently on the stack.
+//   - error: An error if the stack is empty.
+//
+// Example:
+//
+//	s := stack.NewMinStack[int]()
+//	s.Push(3)
+//	s.Push(1)
+//	min, err := s.Min()
+//	if err == nil {
+//	    fmt.Println(min) // 1
+//	}
+func (s *MinStack[T]) Min() (T, error) {
+	if len(s.min) == 0 {
+		var zero T
+		return zero, errors.New("stack empty")
+	}
+	return s.min[len(s.min)-1], nil
+}
+
+// Size returns the number of elements currently in the stack.
+//
+// Returns:
+//   - int: The count of elements in the stack.
+//
+// Example:
+//
+//	s := stack.NewMinStack[int]()
+//	s.Push(1)
+//	fmt.Println(s.Size()) // 1
+func (s *MinStack[T]) Size() int {
+	return len(s.data)
+}
+
+// IsEmpty reports whether the stack contains no elements.
+//
+// Returns:
+//   - bool: true if the stack is empty; false otherwise.
+//
+// Example:
+//
+//	s := stack.NewMinStack[int]()
+//	fmt.Println(s.IsEmpty()) // true
+func (s *MinStack[T]) IsEmpty() bool {
+	return len(s.data) == 0
+}
@@ -0,0 +1,60 @@
+package stack
+
+import "testing"
+
+func TestMinStackTracksRunningMin(t *testing.T) {
+	s := NewMinStack[int]()
+	_, err := s.Min()
+	if err == nil {
+		t.Error("expected error on Min from empty stack")
+	}
+	s.Push(5)
+	s.Push(3)
+	s.Push(7)
+	s.Push(2)
+	min, err := s.Min()
+	if err != nil || min != 2 {
+		t.Errorf("expected min 2, got %d (err: %v)", min, err)
+	}
+	_, _ = s.Pop()
+	min, err = s.Min()
+	if err != nil || min != 3 {
+		t.Errorf("expected min 3 after popping 2, got %d (err: %v)", min, err)
+	}
+}
+
+func TestMinStackPopRestoresPreviousMin(t *testing.T) {
+	s := NewMinStack[int]()
+	s.Push(2)
+	s.Push(2)
+	_, _ = s.Pop()
+	min, err := s.Min()
+	if err != nil || min != 2 {
+		t.Errorf("expected min 2 after popping a duplicate, got %d (err: %v)", min, err)
+	}
+}
+
+func TestMinStackPeekAndSize(t *testing.T) {
+	s := NewMinStack[int]()
+	s.Push(1)
+	s.Push(4)
+	top, err := s.Peek()
+	if err != nil || top != 4 {
+		t.Errorf("expected Peek value 4, got %d (err: %v)", top, err)
+	}
+	if s.Size() != 2 {
+		t.Errorf("expected size 2, got %d", s.Size())
+	}
+}
+
+func TestMinStackFunc(t *testing.T) {
+	type point struct{ x int }
+	s := NewMinStackFunc[point](func(a, b point) bool { return a.x < b.x })
+	s.Push(point{x: 3})
+	s.Push(point{x: 1})
+	s.Push(point{x: 2})
+	min, err := s.Min()
+	if err != nil || min.x != 1 {
+		t.Errorf("expected min x=1, got %+v (err: %v)", min, err)
+	}
+}
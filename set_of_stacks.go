@@ -0,0 +1,205 @@
+package stack
+
+import (
+	"errors"
+	"fmt"
+)
+
+// SetOfStacks is a composite "stack of plates" structure: it behaves like a
+// single logical stack but internally keeps a slice of substacks, each
+// capped at a configured threshold. Once the current top substack is full,
+// Push starts a new one, giving bounded memory per chunk instead of one
+// ever-growing slice.
+type SetOfStacks[T comparable] struct {
+	threshold int
+	stacks    []*SliceStack[T]
+}
+
+// NewSetOfStacks creates and returns a new empty SetOfStacks for type T,
+// where each substack holds at most threshold elements.
+//
+// Parameters:
+//   - threshold: The maximum number of elements per substack. Values less
+//     than 1 are treated as 1.
+//
+// Returns:
+//   - *SetOfStacks[T]: A new empty set of stacks for type T.
+//
+// Example:
+//
+//	s := stack.NewSetOfStacks[int](2)
+//	s.Push(1)
+//	s.Push(2)
+//	s.Push(3)
+//	fmt.Println(s.NumStacks()) // 2
+func NewSetOfStacks[T comparable](threshold int) *SetOfStacks[T] {
+	if threshold < 1 {
+		threshold = 1
+	}
+	return &SetOfStacks[T]{threshold: threshold}
+}
+
+// Push adds a new element to the top of the set of stacks. If the current
+// top substack is full, a new substack is created to hold it.
+//
+// Parameters:
+//   - value: The element to be added.
+//
+// Example:
+//
+//	s := stack.NewSetOfStacks[int](2)
+//	s.Push(1)
+//	s.Push(2)
+func (s *SetOfStacks[T]) Push(value T) {
+	if len(s.stacks) == 0 || s.stacks[len(s.stacks)-1].Size() >= s.threshold {
+		s.stacks = append(s.stacks, NewStack[T]())
+	}
+	s.stacks[len(s.stacks)-1].Push(value)
+}
+
+// Pop removes and returns the top element of the last non-empty substack,
+// discarding any substacks that become empty.
+//
+// Returns:
+//   - value: The popped element.
+//   - error: An error if the set of stacks is empty.
+//
+// Example:
+//
+//	s := stack.NewSetOfStacks[int](2)
+//	s.Push(1)
+//	value, err := s.Pop()
+//	if err == nil {
+//	    fmt.Println(value) // 1
+//	}
+func (s *SetOfStacks[T]) Pop() (T, error) {
+	s.trimEmpty()
+	if len(s.stacks) == 0 {
+		var zero T
+		return zero, errors.New("stack empty")
+	}
+	last := s.stacks[len(s.stacks)-1]
+	value, err := last.Pop()
+	s.trimEmpty()
+	return value, err
+}
+
+// PopAt removes and returns the top element of the substack at the given
+// index, with no rebalancing of the remaining substacks.
+//
+// Parameters:
+//   - index: The index of the substack to pop from.
+//
+// Returns:
+//   - value: The popped element.
+//   - error: An error if the index is out of range or that substack is
+//     empty.
+//
+// Example:
+//
+//	s := stack.NewSetOfStacks[int](2)
+//	s.Push(1)
+//	value, err := s.PopAt(0)
+//	if err == nil {
+//	    fmt.Println(value) // 1
+//	}
+func (s *SetOfStacks[T]) PopAt(index int) (T, error) {
+	if index < 0 || index >= len(s.stacks) {
+		var zero T
+		return zero, fmt.Errorf("substack index %d out of range", index)
+	}
+	return s.stacks[index].Pop()
+}
+
+// NumStacks returns the number of substacks currently in use.
+//
+// Returns:
+//   - int: The count of substacks.
+//
+// Example:
+//
+//	s := stack.NewSetOfStacks[int](2)
+//	s.Push(1)
+//	fmt.Println(s.NumStacks()) // 1
+func (s *SetOfStacks[T]) NumStacks() int {
+	return len(s.stacks)
+}
+
+// Sizes returns the number of elements held by each substack, in order.
+//
+// Returns:
+//   - []int: The size of each substack.
+//
+// Example:
+//
+//	s := stack.NewSetOfStacks[int](2)
+//	s.Push(1)
+//	s.Push(2)
+//	s.Push(3)
+//	fmt.Println(s.Sizes()) // [2 1]
+func (s *SetOfStacks[T]) Sizes() []int {
+	sizes := make([]int, len(s.stacks))
+	for i, sub := range s.stacks {
+		sizes[i] = sub.Size()
+	}
+	return sizes
+}
+
+// Stacks returns the substacks currently in use, in order, for iteration
+// or inspection. The returned slice is a copy, but its elements alias the
+// underlying substacks.
+//
+// Returns:
+//   - []*SliceStack[T]: The substacks in use.
+//
+// Example:
+//
+//	s := stack.NewSetOfStacks[int](2)
+//	s.Push(1)
+//	for _, sub := range s.Stacks() {
+//	    fmt.Println(sub)
+//	}
+func (s *SetOfStacks[T]) Stacks() []*SliceStack[T] {
+	result := make([]*SliceStack[T], len(s.stacks))
+	copy(result, s.stacks)
+	return result
+}
+
+// Size returns the total number of elements across all substacks.
+//
+// Returns:
+//   - int: The total element count.
+//
+// Example:
+//
+//	s := stack.NewSetOfStacks[int](2)
+//	s.Push(1)
+//	s.Push(2)
+//	fmt.Println(s.Size()) // 2
+func (s *SetOfStacks[T]) Size() int {
+	total := 0
+	for _, sub := range s.stacks {
+		total += sub.Size()
+	}
+	return total
+}
+
+// IsEmpty reports whether the set of stacks contains no elements.
+//
+// Returns:
+//   - bool: true if empty; false otherwise.
+//
+// Example:
+//
+//	s := stack.NewSetOfStacks[int](2)
+//	fmt.Println(s.IsEmpty()) // true
+func (s *SetOfStacks[T]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+// trimEmpty discards trailing substacks that have become empty.
+func (s *SetOfStacks[T]) trimEmpty() {
+	for len(s.stacks) > 0 && s.stacks[len(s.stacks)-1].IsEmpty() {
+		s.stacks = s.stacks[:len(s.stacks)-1]
+	}
+}
@@ -0,0 +1,85 @@
+package stack
+
+import "testing"
+
+func TestSetOfStacksPushCreatesNewSubstack(t *testing.T) {
+	s := NewSetOfStacks[int](2)
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+	if s.NumStacks() != 2 {
+		t.Errorf("expected 2 substacks, got %d", s.NumStacks())
+	}
+	sizes := s.Sizes()
+	if len(sizes) != 2 || sizes[0] != 2 || sizes[1] != 1 {
+		t.Errorf("expected sizes [2 1], got %v", sizes)
+	}
+}
+
+func TestSetOfStacksPop(t *testing.T) {
+	s := NewSetOfStacks[int](2)
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+	value, err := s.Pop()
+	if err != nil || value != 3 {
+		t.Errorf("expected popped value 3, got %d (err: %v)", value, err)
+	}
+	if s.NumStacks() != 1 {
+		t.Errorf("expected empty substack to be discarded, got %d substacks", s.NumStacks())
+	}
+	_, _ = s.Pop()
+	_, err = s.Pop()
+	if err != nil {
+		t.Error("unexpected error on Pop:", err)
+	}
+	_, err = s.Pop()
+	if err == nil {
+		t.Error("expected error when popping from empty set of stacks")
+	}
+}
+
+func TestSetOfStacksPopAt(t *testing.T) {
+	s := NewSetOfStacks[int](2)
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+	value, err := s.PopAt(0)
+	if err != nil || value != 2 {
+		t.Errorf("expected popped value 2, got %d (err: %v)", value, err)
+	}
+	if s.NumStacks() != 2 {
+		t.Errorf("expected no rebalancing, got %d substacks", s.NumStacks())
+	}
+	_, err = s.PopAt(5)
+	if err == nil {
+		t.Error("expected error for out-of-range index")
+	}
+}
+
+func TestSetOfStacksSizeAndIsEmpty(t *testing.T) {
+	s := NewSetOfStacks[int](2)
+	if !s.IsEmpty() {
+		t.Error("expected new set of stacks to be empty")
+	}
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+	if s.Size() != 3 {
+		t.Errorf("expected size 3, got %d", s.Size())
+	}
+}
+
+func TestSetOfStacksStacks(t *testing.T) {
+	s := NewSetOfStacks[int](2)
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+	subs := s.Stacks()
+	if len(subs) != 2 {
+		t.Fatalf("expected 2 substacks, got %d", len(subs))
+	}
+	if subs[0].Size() != 2 || subs[1].Size() != 1 {
+		t.Errorf("unexpected substack sizes: %d, %d", subs[0].Size(), subs[1].Size())
+	}
+}
@@ -0,0 +1,239 @@
+package stack
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// SliceStack is a slice-backed implementation of Stack[T].
+//
+// SliceStack[T] holds elements of any comparable type T. Internally, it
+// uses a dynamically growing slice to store elements, offering amortized
+// O(1) push and O(1) pop at the cost of occasional reallocation as the
+// slice grows.
+type SliceStack[T comparable] struct {
+	data []T
+}
+
+// NewStack creates and returns a new empty SliceStack for type T.
+//
+// Returns:
+//   - *SliceStack[T]: A new empty stack for type T.
+//
+// Example:
+//
+//	s := stack.NewStack[int]()
+//	s.Push(42)
+//	fmt.Println(s) // Stack: [42]
+func NewStack[T comparable]() *SliceStack[T] {
+	return &SliceStack[T]{data: make([]T, 0)}
+}
+
+// Push adds a new element to the top of the stack. It always succeeds.
+//
+// Parameters:
+//   - data: The element to be added to the stack.
+//
+// Returns:
+//   - error: Always nil; present to satisfy the Stack[T] interface.
+//
+// Example:
+//
+//	s := stack.NewStack[string]()
+//	s.Push("hello")
+//	s.Push("world")
+func (s *SliceStack[T]) Push(data T) error {
+	s.data = append(s.data, data)
+	return nil
+}
+
+// Pop removes and returns the top element of the stack.
+//
+// Returns:
+//   - value: The top element of the stack.
+//   - error: An error if the stack is empty.
+//
+// If the stack is empty, Pop returns the zero value of T and an error.
+//
+// Example:
+//
+//	s := stack.NewStack[int]()
+//	s.Push(1)
+//	value, err := s.Pop()
+//	if err == nil {
+//	    fmt.Println(value) // 1
+//	}
+func (s *SliceStack[T]) Pop() (T, error) {
+	if s.IsEmpty() {
+		var zero T
+		return zero, errors.New("stack empty")
+	}
+	index := len(s.data) - 1
+	value := s.data[index]
+	s.data = s.data[:index]
+	return value, nil
+}
+
+// Peek returns the top element of the stack without removing it.
+//
+// Returns:
+//   - value: The top element of the stack.
+//   - error: An error if the stack is empty.
+//
+// Example:
+//
+//	s := stack.NewStack[int]()
+//	s.Push(5)
+//	top, err := s.Peek()
+//	if err == nil {
+//	    fmt.Println(top) // 5
+//	}
+func (s *SliceStack[T]) Peek() (T, error) {
+	if s.IsEmpty() {
+		var zero T
+		return zero, errors.New("stack empty")
+	}
+	return s.data[len(s.data)-1], nil
+}
+
+// IsEmpty reports whether the stack contains no elements.
+//
+// Returns:
+//   - bool: true if the stack is empty; false otherwise.
+//
+// Example:
+//
+//	s := stack.NewStack[int]()
+//	fmt.Println(s.IsEmpty()) // true
+func (s *SliceStack[T]) IsEmpty() bool {
+	return len(s.data) == 0
+}
+
+// Size returns the number of elements currently in the stack.
+//
+// Returns:
+//   - int: The count of elements in the stack.
+//
+// Example:
+//
+//	s := stack.NewStack[int]()
+//	s.Push(1)
+//	s.Push(2)
+//	fmt.Println(s.Size()) // 2
+func (s *SliceStack[T]) Size() int {
+	return len(s.data)
+}
+
+// Clear removes all elements from the stack, resetting it to empty.
+//
+// Example:
+//
+//	s := stack.NewStack[int]()
+//	s.Push(1)
+//	s.Clear()
+//	fmt.Println(s.IsEmpty()) // true
+func (s *SliceStack[T]) Clear() {
+	s.data = nil
+}
+
+// String returns a string representation of the stack.
+//
+// Returns:
+//   - string: A string representation of the stack.
+//
+// Example:
+//
+//	s := stack.NewStack[int]()
+//	s.Push(1)
+//	s.Push(2)
+//	fmt.Println(s.String()) // Stack: [1 2]
+func (s *SliceStack[T]) String() string {
+	return fmt.Sprintf("Stack: %v", s.data)
+}
+
+// Clone creates and returns a deep copy of the stack.
+//
+// Returns:
+//   - Stack[T]: A new stack with the same elements.
+//
+// Example:
+//
+//	s := stack.NewStack[int]()
+//	s.Push(1)
+//	clone := s.Clone()
+//	fmt.Println(clone) // Stack: [1]
+func (s *SliceStack[T]) Clone() Stack[T] {
+	newData := make([]T, len(s.data))
+	copy(newData, s.data)
+	return &SliceStack[T]{data: newData}
+}
+
+// Reverse reverses the order of elements in the stack.
+//
+// Example:
+//
+//	s := stack.NewStack[int]()
+//	s.Push(1)
+//	s.Push(2)
+//	s.Push(3)
+//	s.Reverse()
+//	fmt.Println(s) // Stack: [3 2 1]
+func (s *SliceStack[T]) Reverse() {
+	n := s.Size() - 1
+	for i, j := 0, n; i < j; i, j = i+1, j-1 {
+		s.data[i], s.data[j] = s.data[j], s.data[i]
+	}
+}
+
+// ToSlice returns a copy of the stack's elements as a slice.
+//
+// Returns:
+//   - []T: A copy of the stack's internal slice.
+//
+// Example:
+//
+//	s := stack.NewStack[int]()
+//	s.Push(1)
+//	s.Push(2)
+//	slice := s.ToSlice()
+//	fmt.Println(slice) // [1 2]
+func (s *SliceStack[T]) ToSlice() []T {
+	result := make([]T, s.Size())
+	copy(result, s.data)
+	return result
+}
+
+// Capacity returns the current capacity of the underlying slice.
+//
+// Returns:
+//   - int: The capacity of the stack's internal slice.
+//
+// Example:
+//
+//	s := stack.NewStack[int]()
+//	fmt.Println(s.Capacity()) // 0 (initially)
+func (s *SliceStack[T]) Capacity() int {
+	return cap(s.data)
+}
+
+// Contains reports whether the stack contains the given value.
+//
+// Parameters:
+//   - value: The value to search for.
+//
+// Returns:
+//   - bool: true if the value exists in the stack; false otherwise.
+//
+// Example:
+//
+//	s := stack.NewStack[int]()
+//	s.Push(10)
+//	fmt.Println(s.Contains(10)) // true
+//	fmt.Println(s.Contains(5))  // false
+func (s *SliceStack[T]) Contains(value T) bool {
+	return slices.Contains(s.data, value)
+}
+
+// compile-time check that SliceStack implements Stack.
+var _ Stack[int] = (*SliceStack[int])(nil)
@@ -0,0 +1,183 @@
+package stack
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// MarshalJSON encodes the stack as a JSON array, ordered from bottom to
+// top, satisfying json.Marshaler.
+//
+// Returns:
+//   - []byte: The JSON-encoded array of elements.
+//   - error: An error if encoding fails.
+//
+// Example:
+//
+//	s := stack.NewStack[int]()
+//	s.Push(1)
+//	s.Push(2)
+//	data, _ := s.MarshalJSON()
+//	fmt.Println(string(data)) // [1,2]
+func (s *SliceStack[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.data)
+}
+
+// UnmarshalJSON decodes a JSON array, ordered from bottom to top, into the
+// stack, satisfying json.Unmarshaler. Any existing elements are discarded.
+//
+// Parameters:
+//   - data: The JSON-encoded array of elements.
+//
+// Returns:
+//   - error: An error if decoding fails.
+//
+// Example:
+//
+//	s := stack.NewStack[int]()
+//	err := s.UnmarshalJSON([]byte("[1,2]"))
+//	if err == nil {
+//	    fmt.Println(s) // Stack: [1 2]
+//	}
+func (s *SliceStack[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	s.data = values
+	return nil
+}
+
+// GobEncode encodes the stack's elements, ordered from bottom to top,
+// satisfying gob.GobEncoder.
+//
+// Returns:
+//   - []byte: The gob-encoded elements.
+//   - error: An error if encoding fails.
+//
+// Example:
+//
+//	s := stack.NewStack[int]()
+//	s.Push(1)
+//	data, _ := s.GobEncode()
+func (s *SliceStack[T]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s.data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode decodes gob-encoded elements into the stack, satisfying
+// gob.GobDecoder. Any existing elements are discarded.
+//
+// Parameters:
+//   - data: The gob-encoded elements.
+//
+// Returns:
+//   - error: An error if decoding fails.
+//
+// Example:
+//
+//	s := stack.NewStack[int]()
+//	err := s.GobDecode(data)
+func (s *SliceStack[T]) GobDecode(data []byte) error {
+	var values []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return err
+	}
+	s.data = values
+	return nil
+}
+
+// WriteTo writes the stack to w using a length-prefixed binary framing: a
+// varint element count followed by the gob-encoded elements. This allows
+// large stacks to be checkpointed to disk or sent over the network.
+//
+// Parameters:
+//   - w: The writer to checkpoint the stack to.
+//
+// Returns:
+//   - int64: The number of bytes written.
+//   - error: An error if writing fails.
+//
+// Example:
+//
+//	s := stack.NewStack[int]()
+//	s.Push(1)
+//	var buf bytes.Buffer
+//	n, err := s.WriteTo(&buf)
+func (s *SliceStack[T]) WriteTo(w io.Writer) (int64, error) {
+	var header [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(header[:], uint64(len(s.data)))
+	written, err := w.Write(header[:n])
+	total := int64(written)
+	if err != nil {
+		return total, err
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s.data); err != nil {
+		return total, err
+	}
+	written, err = w.Write(buf.Bytes())
+	total += int64(written)
+	return total, err
+}
+
+// ReadFrom reads a stack previously written by WriteTo from r, replacing
+// any existing elements.
+//
+// Parameters:
+//   - r: The reader to restore the stack from.
+//
+// Returns:
+//   - int64: The number of bytes read.
+//   - error: An error if reading fails, or if the decoded element count
+//     does not match the framed count.
+//
+// Example:
+//
+//	s := stack.NewStack[int]()
+//	n, err := s.ReadFrom(&buf)
+func (s *SliceStack[T]) ReadFrom(r io.Reader) (int64, error) {
+	cr := &countingReader{r: r}
+	count, err := binary.ReadUvarint(cr)
+	if err != nil {
+		return cr.n, err
+	}
+	var values []T
+	if err := gob.NewDecoder(cr).Decode(&values); err != nil {
+		return cr.n, err
+	}
+	if uint64(len(values)) != count {
+		return cr.n, fmt.Errorf("stack: expected %d elements, decoded %d", count, len(values))
+	}
+	s.data = values
+	return cr.n, nil
+}
+
+// countingReader wraps an io.Reader, tracking how many bytes have been
+// consumed across both the varint header and the gob payload that follows
+// it, without over-buffering ahead of what each phase actually needs.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func (c *countingReader) ReadByte() (byte, error) {
+	var buf [1]byte
+	if _, err := io.ReadFull(c, buf[:]); err != nil {
+		return 0, err
+	}
+	return buf[0], nil
+}
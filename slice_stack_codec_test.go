@@ -0,0 +1,72 @@
+package stack
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+)
+
+func TestSliceStackJSONRoundTrip(t *testing.T) {
+	s := NewStack[int]()
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+	data, err := s.MarshalJSON()
+	if err != nil {
+		t.Fatal("unexpected error on MarshalJSON:", err)
+	}
+	want := "[1,2,3]"
+	if string(data) != want {
+		t.Errorf("expected %q, got %q", want, string(data))
+	}
+	restored := NewStack[int]()
+	if err := restored.UnmarshalJSON(data); err != nil {
+		t.Fatal("unexpected error on UnmarshalJSON:", err)
+	}
+	if !slices.Equal(restored.ToSlice(), s.ToSlice()) {
+		t.Errorf("expected restored stack to equal original, got %v vs %v", restored.ToSlice(), s.ToSlice())
+	}
+}
+
+func TestSliceStackGobRoundTrip(t *testing.T) {
+	s := NewStack[string]()
+	s.Push("a")
+	s.Push("b")
+	data, err := s.GobEncode()
+	if err != nil {
+		t.Fatal("unexpected error on GobEncode:", err)
+	}
+	restored := NewStack[string]()
+	if err := restored.GobDecode(data); err != nil {
+		t.Fatal("unexpected error on GobDecode:", err)
+	}
+	if !slices.Equal(restored.ToSlice(), s.ToSlice()) {
+		t.Errorf("expected restored stack to equal original, got %v vs %v", restored.ToSlice(), s.ToSlice())
+	}
+}
+
+func TestSliceStackWriteAndReadFrom(t *testing.T) {
+	s := NewStack[int]()
+	for i := 0; i < 5; i++ {
+		s.Push(i)
+	}
+	var buf bytes.Buffer
+	n, err := s.WriteTo(&buf)
+	if err != nil {
+		t.Fatal("unexpected error on WriteTo:", err)
+	}
+	if n != int64(buf.Len()) {
+		t.Errorf("expected WriteTo to report %d bytes written, got %d", buf.Len(), n)
+	}
+	restored := NewStack[int]()
+	readN, err := restored.ReadFrom(&buf)
+	if err != nil {
+		t.Fatal("unexpected error on ReadFrom:", err)
+	}
+	if readN != n {
+		t.Errorf("expected ReadFrom to report %d bytes read, got %d", n, readN)
+	}
+	if !slices.Equal(restored.ToSlice(), s.ToSlice()) {
+		t.Errorf("expected restored stack to equal original, got %v vs %v", restored.ToSlice(), s.ToSlice())
+	}
+}
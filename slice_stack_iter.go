@@ -0,0 +1,154 @@
+package stack
+
+import (
+	"iter"
+	"slices"
+)
+
+// All returns an iterator over the stack's elements, from top to bottom.
+//
+// Returns:
+//   - iter.Seq[T]: An iterator yielding elements top to bottom.
+//
+// Example:
+//
+//	s := stack.NewStack[int]()
+//	s.Push(1)
+//	s.Push(2)
+//	for v := range s.All() {
+//	    fmt.Println(v) // 2, then 1
+//	}
+func (s *SliceStack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := len(s.data) - 1; i >= 0; i-- {
+			if !yield(s.data[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the stack's elements, from bottom to
+// top.
+//
+// Returns:
+//   - iter.Seq[T]: An iterator yielding elements bottom to top.
+//
+// Example:
+//
+//	s := stack.NewStack[int]()
+//	s.Push(1)
+//	s.Push(2)
+//	for v := range s.Backward() {
+//	    fmt.Println(v) // 1, then 2
+//	}
+func (s *SliceStack[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s.data {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Filter returns a new stack containing only the elements for which pred
+// returns true, preserving their relative order.
+//
+// Parameters:
+//   - pred: The predicate each element must satisfy to be kept.
+//
+// Returns:
+//   - *SliceStack[T]: A new stack with the filtered elements.
+//
+// Example:
+//
+//	s := stack.NewStack[int]()
+//	s.Push(1)
+//	s.Push(2)
+//	s.Push(3)
+//	evens := s.Filter(func(v int) bool { return v%2 == 0 })
+//	fmt.Println(evens) // Stack: [2]
+func (s *SliceStack[T]) Filter(pred func(T) bool) *SliceStack[T] {
+	result := NewStack[T]()
+	for _, v := range s.data {
+		if pred(v) {
+			result.Push(v)
+		}
+	}
+	return result
+}
+
+// Reduce folds the stack's elements, from bottom to top, into a single
+// value using f, starting from init.
+//
+// Parameters:
+//   - init: The initial accumulator value.
+//   - f: The function combining the accumulator with each element.
+//
+// Returns:
+//   - T: The final accumulated value.
+//
+// Example:
+//
+//	s := stack.NewStack[int]()
+//	s.Push(1)
+//	s.Push(2)
+//	s.Push(3)
+//	sum := s.Reduce(0, func(acc, v int) int { return acc + v })
+//	fmt.Println(sum) // 6
+func (s *SliceStack[T]) Reduce(init T, f func(acc, v T) T) T {
+	acc := init
+	for _, v := range s.data {
+		acc = f(acc, v)
+	}
+	return acc
+}
+
+// Equal reports whether two stacks hold the same elements in the same
+// order.
+//
+// Parameters:
+//   - other: The stack to compare against.
+//
+// Returns:
+//   - bool: true if both stacks hold equal elements in equal order.
+//
+// Example:
+//
+//	a := stack.NewStack[int]()
+//	a.Push(1)
+//	b := stack.NewStack[int]()
+//	b.Push(1)
+//	fmt.Println(a.Equal(b)) // true
+func (s *SliceStack[T]) Equal(other *SliceStack[T]) bool {
+	return slices.Equal(s.data, other.data)
+}
+
+// Map applies f to every element of s, from bottom to top, and returns a
+// new stack holding the results in the same order.
+//
+// Map is a free function, not a method, because Go methods cannot
+// introduce new type parameters.
+//
+// Parameters:
+//   - s: The stack to transform.
+//   - f: The function applied to each element.
+//
+// Returns:
+//   - *SliceStack[U]: A new stack holding the transformed elements.
+//
+// Example:
+//
+//	s := stack.NewStack[int]()
+//	s.Push(1)
+//	s.Push(2)
+//	doubled := stack.Map(s, func(v int) int { return v * 2 })
+//	fmt.Println(doubled) // Stack: [2 4]
+func Map[T, U comparable](s *SliceStack[T], f func(T) U) *SliceStack[U] {
+	result := NewStack[U]()
+	for _, v := range s.data {
+		result.Push(f(v))
+	}
+	return result
+}
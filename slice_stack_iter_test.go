@@ -0,0 +1,96 @@
+package stack
+
+import "testing"
+
+func TestSliceStackAll(t *testing.T) {
+	s := NewStack[int]()
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+	var got []int
+	for v := range s.All() {
+		got = append(got, v)
+	}
+	want := []int{3, 2, 1}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i, v := range want {
+		if got[i] != v {
+			t.Errorf("expected %v at position %d, got %v", v, i, got[i])
+		}
+	}
+}
+
+func TestSliceStackBackward(t *testing.T) {
+	s := NewStack[int]()
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+	var got []int
+	for v := range s.Backward() {
+		got = append(got, v)
+	}
+	want := []int{1, 2, 3}
+	for i, v := range want {
+		if got[i] != v {
+			t.Errorf("expected %v at position %d, got %v", v, i, got[i])
+		}
+	}
+}
+
+func TestSliceStackFilter(t *testing.T) {
+	s := NewStack[int]()
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+	s.Push(4)
+	evens := s.Filter(func(v int) bool { return v%2 == 0 })
+	want := []int{2, 4}
+	got := evens.ToSlice()
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestSliceStackReduce(t *testing.T) {
+	s := NewStack[int]()
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+	sum := s.Reduce(0, func(acc, v int) int { return acc + v })
+	if sum != 6 {
+		t.Errorf("expected 6, got %d", sum)
+	}
+}
+
+func TestSliceStackEqual(t *testing.T) {
+	a := NewStack[int]()
+	a.Push(1)
+	a.Push(2)
+	b := NewStack[int]()
+	b.Push(1)
+	b.Push(2)
+	if !a.Equal(b) {
+		t.Error("expected equal stacks to compare equal")
+	}
+	b.Push(3)
+	if a.Equal(b) {
+		t.Error("expected stacks of different sizes to compare unequal")
+	}
+}
+
+func TestMap(t *testing.T) {
+	s := NewStack[int]()
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+	doubled := Map(s, func(v int) int { return v * 2 })
+	want := []int{2, 4, 6}
+	got := doubled.ToSlice()
+	for i, v := range want {
+		if got[i] != v {
+			t.Errorf("expected %v at position %d, got %v", v, i, got[i])
+		}
+	}
+}